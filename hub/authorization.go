@@ -0,0 +1,61 @@
+package hub
+
+import (
+	"errors"
+	"net/http"
+)
+
+const (
+	bearerPrefix        = "Bearer "
+	authorizationCookie = "mercureAuthorization"
+)
+
+var (
+	errInvalidJWT = errors.New("invalid JWT")
+	errNoJWT      = errors.New("missing JWT")
+)
+
+// extractJWT retrieves the JWT used to authenticate the request, looking first at the
+// Authorization header, then, if allowed, at the mercureAuthorization cookie.
+func extractJWT(r *http.Request, allowCookie bool) (string, error) {
+	if authorizationHeader := r.Header.Get("Authorization"); authorizationHeader != "" {
+		if len(authorizationHeader) < len(bearerPrefix) || authorizationHeader[:len(bearerPrefix)] != bearerPrefix {
+			return "", errInvalidJWT
+		}
+
+		return authorizationHeader[len(bearerPrefix):], nil
+	}
+
+	if !allowCookie {
+		return "", errNoJWT
+	}
+
+	cookie, err := r.Cookie(authorizationCookie)
+	if err != nil {
+		return "", errNoJWT
+	}
+
+	return cookie.Value, nil
+}
+
+// canReceiveUpdate returns true when the subscriber is allowed to receive the given update,
+// taking into account the "Private" flag and the topics granted by its JWT claims.
+func canReceiveUpdate(s *Subscriber, u *Update) bool {
+	if !u.Private {
+		return true
+	}
+
+	if s.Claims == nil {
+		return false
+	}
+
+	for _, grantedTopic := range s.Claims.Mercure.Subscribe {
+		for _, t := range u.Topics {
+			if topicSelectorMatches(grantedTopic, t) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,142 @@
+package hub
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AuthorizationRequest is the JSON payload POSTed to the configured authorizer_url so an
+// external service can decide whether a subscribe or publish request should be allowed.
+type AuthorizationRequest struct {
+	Subject    string   `json:"subject"`
+	Topics     []string `json:"topics"`
+	RemoteAddr string   `json:"remoteAddr"`
+	Method     string   `json:"method"`
+}
+
+// AuthorizationDecision is the JSON response expected from the authorizer_url endpoint.
+type AuthorizationDecision struct {
+	Allowed       bool     `json:"allowed"`
+	Reason        string   `json:"reason"`
+	AllowedTopics []string `json:"allowedTopics"`
+}
+
+const (
+	authorizeSubscribe = "subscribe"
+	authorizePublish   = "publish"
+)
+
+// errAuthorizerUnavailable is returned when authorizer_url responds with anything other than a
+// 200, so callers treat an authorizer outage as "service unavailable" rather than a denial.
+var errAuthorizerUnavailable = errors.New("authorizer unavailable")
+
+// Authorizer delegates subscribe/publish authorization decisions to an external HTTPS endpoint,
+// mirroring Kubernetes-style webhook authorizers so operators can centralize policy without
+// minting per-user JWTs. Decisions are cached for ttl to avoid a round-trip per event.
+type Authorizer struct {
+	url        string
+	ttl        time.Duration
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cachedDecision
+}
+
+type cachedDecision struct {
+	decision  *AuthorizationDecision
+	expiresAt time.Time
+}
+
+// NewAuthorizer creates an Authorizer that POSTs review requests to url, caching decisions for ttl
+// (a ttl of 0 disables caching).
+func NewAuthorizer(url string, ttl time.Duration) *Authorizer {
+	return &Authorizer{
+		url:        url,
+		ttl:        ttl,
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		cache:      make(map[string]cachedDecision),
+	}
+}
+
+// Authorize asks the external authorizer whether subject is allowed to perform method (subscribe
+// or publish) on topics from remoteAddr.
+func (a *Authorizer) Authorize(subject, remoteAddr, method string, topics []string) (*AuthorizationDecision, error) {
+	key := cacheKey(subject, remoteAddr, method, topics)
+
+	if d, ok := a.cachedDecision(key); ok {
+		return d, nil
+	}
+
+	req := AuthorizationRequest{
+		Subject:    subject,
+		Topics:     topics,
+		RemoteAddr: remoteAddr,
+		Method:     method,
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.httpClient.Post(a.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: authorizer_url returned status %d", errAuthorizerUnavailable, resp.StatusCode)
+	}
+
+	var decision AuthorizationDecision
+	if err := json.NewDecoder(resp.Body).Decode(&decision); err != nil {
+		return nil, err
+	}
+
+	a.storeDecision(key, &decision)
+
+	return &decision, nil
+}
+
+func (a *Authorizer) cachedDecision(key string) (*AuthorizationDecision, bool) {
+	if a.ttl <= 0 {
+		return nil, false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	d, ok := a.cache[key]
+	if !ok || time.Now().After(d.expiresAt) {
+		return nil, false
+	}
+
+	return d.decision, true
+}
+
+func (a *Authorizer) storeDecision(key string, d *AuthorizationDecision) {
+	if a.ttl <= 0 {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.cache[key] = cachedDecision{decision: d, expiresAt: time.Now().Add(a.ttl)}
+}
+
+func cacheKey(subject, remoteAddr, method string, topics []string) string {
+	key := subject + "\x00" + remoteAddr + "\x00" + method
+
+	for _, t := range topics {
+		key += "\x00" + t
+	}
+
+	return key
+}
@@ -0,0 +1,119 @@
+package hub
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAuthorizerServer(t *testing.T, decide func(AuthorizationRequest) AuthorizationDecision) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req AuthorizationRequest
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			return
+		}
+
+		assert.NoError(t, json.NewEncoder(w).Encode(decide(req)))
+	}))
+}
+
+func TestAuthorizerAllow(t *testing.T) {
+	server := newAuthorizerServer(t, func(AuthorizationRequest) AuthorizationDecision {
+		return AuthorizationDecision{Allowed: true}
+	})
+	defer server.Close()
+
+	a := NewAuthorizer(server.URL, 0)
+	decision, err := a.Authorize("alice", "127.0.0.1", authorizeSubscribe, []string{"http://example.com/books/1"})
+
+	assert.NoError(t, err)
+	assert.True(t, decision.Allowed)
+}
+
+func TestAuthorizerDeny(t *testing.T) {
+	server := newAuthorizerServer(t, func(AuthorizationRequest) AuthorizationDecision {
+		return AuthorizationDecision{Allowed: false, Reason: "not a subscriber"}
+	})
+	defer server.Close()
+
+	a := NewAuthorizer(server.URL, 0)
+	decision, err := a.Authorize("alice", "127.0.0.1", authorizeSubscribe, []string{"http://example.com/books/1"})
+
+	assert.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, "not a subscriber", decision.Reason)
+}
+
+func TestAuthorizerPartialAllow(t *testing.T) {
+	server := newAuthorizerServer(t, func(req AuthorizationRequest) AuthorizationDecision {
+		return AuthorizationDecision{Allowed: true, AllowedTopics: []string{req.Topics[0]}}
+	})
+	defer server.Close()
+
+	a := NewAuthorizer(server.URL, 0)
+	decision, err := a.Authorize("alice", "127.0.0.1", authorizeSubscribe, []string{"http://example.com/books/1", "http://example.com/books/2"})
+
+	assert.NoError(t, err)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, []string{"http://example.com/books/1"}, decision.AllowedTopics)
+}
+
+func TestAuthorizerCachesDecisions(t *testing.T) {
+	calls := 0
+	server := newAuthorizerServer(t, func(AuthorizationRequest) AuthorizationDecision {
+		calls++
+
+		return AuthorizationDecision{Allowed: true}
+	})
+	defer server.Close()
+
+	a := NewAuthorizer(server.URL, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		_, err := a.Authorize("alice", "127.0.0.1", authorizeSubscribe, []string{"http://example.com/books/1"})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestAuthorizerNon200ResponseIsAnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	a := NewAuthorizer(server.URL, 0)
+	decision, err := a.Authorize("alice", "127.0.0.1", authorizeSubscribe, []string{"http://example.com/books/1"})
+
+	assert.Nil(t, decision)
+	assert.ErrorIs(t, err, errAuthorizerUnavailable)
+}
+
+func TestSubscribeAuthorizerDeny(t *testing.T) {
+	server := newAuthorizerServer(t, func(AuthorizationRequest) AuthorizationDecision {
+		return AuthorizationDecision{Allowed: false, Reason: "denied by policy"}
+	})
+	defer server.Close()
+
+	config := NewViper()
+	config.Set("authorizer_url", server.URL)
+
+	hub := createDummyWithTransportAndConfig(NewLocalTransport(NewMetrics()), config)
+	defer hub.Stop()
+
+	req := httptest.NewRequest("GET", defaultHubURL+"?topic=http://example.com/books/1", nil)
+	w := httptest.NewRecorder()
+
+	hub.SubscribeHandler(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, "denied by policy\n", w.Body.String())
+}
@@ -0,0 +1,218 @@
+package hub
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net/url"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var bucketName = []byte("updates")
+
+// BoltTransport implements the Transport interface using the BoltDB embedded database, so
+// dispatched updates survive a hub restart and late subscribers can replay history starting
+// from their Last-Event-ID.
+type BoltTransport struct {
+	sync.RWMutex
+	db          *bolt.DB
+	subscribers map[*Subscriber]struct{}
+	metrics     *Metrics
+	observable  *observable
+	closed      chan struct{}
+	closeOnce   sync.Once
+}
+
+// NewBoltTransport creates a new BoltTransport backed by the database pointed to by u (e.g.
+// "bolt://updates.db").
+func NewBoltTransport(u *url.URL) (*BoltTransport, error) {
+	path := u.Host + u.Path
+	if path == "" {
+		path = "updates.db"
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 10 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketName)
+
+		return err
+	}); err != nil {
+		db.Close()
+
+		return nil, err
+	}
+
+	metrics := NewMetrics()
+
+	return &BoltTransport{
+		db:          db,
+		subscribers: make(map[*Subscriber]struct{}),
+		metrics:     metrics,
+		observable:  newObservable(metrics),
+		closed:      make(chan struct{}),
+	}, nil
+}
+
+// RegisterObserver registers o to be notified of every update this transport dispatches, on a
+// goroutine dedicated to observers so a slow one never delays SSE delivery.
+func (t *BoltTransport) RegisterObserver(o Observer) {
+	t.observable.register(o)
+}
+
+// DeregisterObserver removes a previously registered Observer.
+func (t *BoltTransport) DeregisterObserver(o Observer) {
+	t.observable.deregister(o)
+}
+
+// AddSubscriber adds a new subscriber to the list of subscribers, replaying history since its
+// RequestLastEventID first, and starts monitoring its outbound buffer for slow-consumer eviction.
+// It is pruned from the subscriber set once it disconnects, so the transport's memory usage stays
+// proportional to concurrent connections rather than growing with every connection the hub has
+// ever seen.
+func (t *BoltTransport) AddSubscriber(s *Subscriber) error {
+	select {
+	case <-t.closed:
+		return errTransportClosed
+	default:
+	}
+
+	if s.RequestLastEventID != "" {
+		if err := t.replay(s); err != nil {
+			return err
+		}
+	}
+
+	t.Lock()
+	t.subscribers[s] = struct{}{}
+	t.Unlock()
+
+	go s.watchCapacity(func(s *Subscriber) {
+		t.metrics.SubscriberEvicted()
+	})
+
+	go t.reapSubscriber(s)
+
+	return nil
+}
+
+// reapSubscriber removes s from the subscriber set once it disconnects, whether cleanly or
+// through eviction.
+func (t *BoltTransport) reapSubscriber(s *Subscriber) {
+	<-s.Disconnected()
+
+	t.Lock()
+	delete(t.subscribers, s)
+	t.Unlock()
+}
+
+// replay sends every update stored after s.RequestLastEventID to the subscriber.
+func (t *BoltTransport) replay(s *Subscriber) error {
+	return t.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketName)
+		c := b.Cursor()
+
+		afterLastEventID := s.RequestLastEventID == ""
+
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var u Update
+			if err := json.Unmarshal(v, &u); err != nil {
+				return err
+			}
+
+			if !afterLastEventID {
+				if u.Event.ID == s.RequestLastEventID {
+					afterLastEventID = true
+				}
+
+				continue
+			}
+
+			s.Dispatch(&u)
+		}
+
+		return nil
+	})
+}
+
+// Dispatch persists the update, fans it out synchronously to matching HTTP SSE subscribers
+// (never blocking on any single slow one), then notifies registered Observers asynchronously on
+// their dedicated goroutine.
+func (t *BoltTransport) Dispatch(update *Update) error {
+	select {
+	case <-t.closed:
+		return errTransportClosed
+	default:
+	}
+
+	if err := t.persist(update); err != nil {
+		return err
+	}
+
+	t.RLock()
+	for s := range t.subscribers {
+		select {
+		case <-s.Disconnected():
+			continue
+		default:
+		}
+
+		s.Dispatch(update)
+	}
+	t.RUnlock()
+
+	t.observable.notify(update)
+
+	return nil
+}
+
+func (t *BoltTransport) persist(update *Update) error {
+	return t.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketName)
+
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		v, err := json.Marshal(update)
+		if err != nil {
+			return err
+		}
+
+		return b.Put(sequenceKey(seq), v)
+	})
+}
+
+func sequenceKey(seq uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, seq)
+
+	return k
+}
+
+// Close closes the underlying database and disconnects every currently connected subscriber.
+func (t *BoltTransport) Close() error {
+	var err error
+
+	t.closeOnce.Do(func() {
+		close(t.closed)
+
+		t.Lock()
+		for s := range t.subscribers {
+			s.Disconnect()
+		}
+		t.Unlock()
+
+		t.observable.stop()
+
+		err = t.db.Close()
+	})
+
+	return err
+}
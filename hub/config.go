@@ -0,0 +1,16 @@
+package hub
+
+import "github.com/spf13/viper"
+
+// NewViper creates a viper.Viper pre-populated with the hub's default configuration.
+func NewViper() *viper.Viper {
+	v := viper.New()
+	v.SetDefault("subscriber_capacity", defaultSubscriberCapacity)
+	v.SetDefault("subscriber_eviction_grace_period", "5s")
+	v.SetDefault("authorizer_cache_ttl", "0s")
+	v.SetDefault("grpc_addr", "")
+	v.SetDefault("grpc_tls_cert_file", "")
+	v.SetDefault("grpc_tls_key_file", "")
+
+	return v
+}
@@ -0,0 +1,9 @@
+package hub
+
+// Event represents an update sent to subscribers, in the Server-Sent Events format.
+type Event struct {
+	ID    string
+	Type  string
+	Retry uint64
+	Data  string
+}
@@ -0,0 +1,190 @@
+package hub
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Filter is a compiled predicate over an Update's metadata and JSON payload fields, as parsed
+// from the "filter" subscribe parameter (e.g. "event.type=review AND data.score>4"). A nil Filter
+// matches everything.
+type Filter struct {
+	root filterNode
+}
+
+// filterNode is a node of the filter AST. Every node type implements eval against an Update.
+type filterNode interface {
+	eval(u *Update) bool
+}
+
+// ParseFilter parses expr into a Filter. Errors are returned for malformed expressions so callers
+// (e.g. SubscribeHandler) can turn them into a 400 response at subscribe time.
+func ParseFilter(expr string) (*Filter, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+
+	p := &filterParser{tokens: tokenizeFilter(expr)}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected token %q", errInvalidFilter, p.tokens[p.pos])
+	}
+
+	return &Filter{root: node}, nil
+}
+
+// Match reports whether u satisfies the filter. A nil Filter (or receiver) matches everything.
+func (f *Filter) Match(u *Update) bool {
+	if f == nil || f.root == nil {
+		return true
+	}
+
+	return f.root.eval(u)
+}
+
+var errInvalidFilter = fmt.Errorf("invalid filter expression")
+
+// --- AST nodes ---
+
+type andNode struct{ left, right filterNode }
+
+func (n *andNode) eval(u *Update) bool { return n.left.eval(u) && n.right.eval(u) }
+
+type orNode struct{ left, right filterNode }
+
+func (n *orNode) eval(u *Update) bool { return n.left.eval(u) || n.right.eval(u) }
+
+type notNode struct{ inner filterNode }
+
+func (n *notNode) eval(u *Update) bool { return !n.inner.eval(u) }
+
+type eventTypeNode struct{ value string }
+
+func (n *eventTypeNode) eval(u *Update) bool { return u.Event.Type == n.value }
+
+type existsNode struct{ path string }
+
+func (n *existsNode) eval(u *Update) bool {
+	_, ok := lookupField(u, n.path)
+
+	return ok
+}
+
+type containsNode struct {
+	path  string
+	value string
+}
+
+func (n *containsNode) eval(u *Update) bool {
+	v, ok := lookupField(u, n.path)
+	if !ok {
+		return false
+	}
+
+	s, ok := v.(string)
+
+	return ok && strings.Contains(s, n.value)
+}
+
+type comparisonNode struct {
+	path     string
+	operator string
+	value    string
+}
+
+func (n *comparisonNode) eval(u *Update) bool {
+	v, ok := lookupField(u, n.path)
+	if !ok {
+		return false
+	}
+
+	if num, ok := v.(float64); ok {
+		if target, err := strconv.ParseFloat(n.value, 64); err == nil {
+			return compareNumbers(num, n.operator, target)
+		}
+	}
+
+	return compareStrings(fmt.Sprintf("%v", v), n.operator, n.value)
+}
+
+func compareNumbers(a float64, op string, b float64) bool {
+	switch op {
+	case "=":
+		return a == b
+	case "!=":
+		return a != b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	default:
+		return false
+	}
+}
+
+func compareStrings(a, op, b string) bool {
+	switch op {
+	case "=":
+		return a == b
+	case "!=":
+		return a != b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	default:
+		return false
+	}
+}
+
+// lookupField resolves a dotted field path against the update. "event.type" and "event.id" read
+// from Event directly; "data.<path>" is looked up in the JSON-decoded Data payload. Any other
+// path is never found.
+func lookupField(u *Update, path string) (interface{}, bool) {
+	switch path {
+	case "event.type":
+		return u.Event.Type, true
+	case "event.id":
+		return u.Event.ID, true
+	}
+
+	const dataPrefix = "data."
+	if !strings.HasPrefix(path, dataPrefix) {
+		return nil, false
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(u.Event.Data), &payload); err != nil {
+		return nil, false
+	}
+
+	var cur interface{} = payload
+	for _, segment := range strings.Split(strings.TrimPrefix(path, dataPrefix), ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		cur, ok = m[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return cur, true
+}
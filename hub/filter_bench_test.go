@@ -0,0 +1,33 @@
+package hub
+
+import "testing"
+
+func BenchmarkFilterMatchSimple(b *testing.B) {
+	f, err := ParseFilter("event.type=review")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	u := &Update{Event: Event{Type: "review"}}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		f.Match(u)
+	}
+}
+
+func BenchmarkFilterMatchCompound(b *testing.B) {
+	f, err := ParseFilter("event.type=review AND data.score>4")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	u := &Update{Event: Event{Type: "review", Data: `{"score":5}`}}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		f.Match(u)
+	}
+}
@@ -0,0 +1,206 @@
+package hub
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tokenizeFilter splits a filter expression into tokens, treating parentheses as standalone
+// tokens (even when not separated by whitespace in the source) and keeping quoted strings intact,
+// including any whitespace they contain (e.g. `data.title CONTAINS "hello world"` yields the
+// single value token `"hello world"`, not two tokens).
+func tokenizeFilter(expr string) []string {
+	var tokens []string
+
+	var tok strings.Builder
+
+	inQuotes := false
+
+	flush := func() {
+		if tok.Len() > 0 {
+			tokens = append(tokens, tok.String())
+			tok.Reset()
+		}
+	}
+
+	for _, r := range expr {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+
+			tok.WriteRune(r)
+		case inQuotes:
+			tok.WriteRune(r)
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			flush()
+		default:
+			tok.WriteRune(r)
+		}
+	}
+
+	flush()
+
+	return tokens
+}
+
+// filterOperators lists comparison operators in an order where every two-character operator is
+// checked before the one-character operator it contains (e.g. "!=" before "=", "<=" before "<").
+var filterOperators = []string{"!=", "<=", ">=", "=", "<", ">"}
+
+// splitComparison splits a "path<op>value" token (e.g. "data.score>4") into its three parts.
+func splitComparison(tok string) (path, op, value string, err error) {
+	for _, candidate := range filterOperators {
+		if idx := strings.Index(tok, candidate); idx > 0 {
+			return tok[:idx], candidate, tok[idx+len(candidate):], nil
+		}
+	}
+
+	return "", "", "", fmt.Errorf("%w: %q is not a valid comparison", errInvalidFilter, tok)
+}
+
+// unquote strips a surrounding pair of double quotes, if present.
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+
+	return s
+}
+
+// filterParser is a recursive-descent parser for the filter grammar:
+//
+//	or   := and (OR and)*
+//	and  := not (AND not)*
+//	not  := NOT not | primary
+//	primary := "(" or ")" | path CONTAINS value | path EXISTS | path<op>value
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() string {
+	t := p.peek()
+	p.pos++
+
+	return t
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for strings.EqualFold(p.peek(), "OR") {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &orNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for strings.EqualFold(p.peek(), "AND") {
+		p.next()
+
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &andNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (filterNode, error) {
+	if strings.EqualFold(p.peek(), "NOT") {
+		p.next()
+
+		inner, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return &notNode{inner}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *filterParser) parsePrimary() (filterNode, error) {
+	tok := p.peek()
+	if tok == "" {
+		return nil, fmt.Errorf("%w: unexpected end of expression", errInvalidFilter)
+	}
+
+	if tok == "(" {
+		p.next()
+
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("%w: missing closing parenthesis", errInvalidFilter)
+		}
+
+		p.next()
+
+		return node, nil
+	}
+
+	p.next()
+
+	if strings.EqualFold(p.peek(), "CONTAINS") {
+		p.next()
+
+		value := p.next()
+		if value == "" {
+			return nil, fmt.Errorf("%w: CONTAINS requires a value", errInvalidFilter)
+		}
+
+		return &containsNode{path: tok, value: unquote(value)}, nil
+	}
+
+	if strings.EqualFold(p.peek(), "EXISTS") {
+		p.next()
+
+		return &existsNode{path: tok}, nil
+	}
+
+	path, op, value, err := splitComparison(tok)
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "event.type" && op == "=" {
+		return &eventTypeNode{value: unquote(value)}, nil
+	}
+
+	return &comparisonNode{path: path, operator: op, value: unquote(value)}, nil
+}
@@ -0,0 +1,148 @@
+package hub
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseFilter(t *testing.T, expr string) *Filter {
+	t.Helper()
+
+	f, err := ParseFilter(expr)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return f
+}
+
+func TestFilterEmptyMatchesEverything(t *testing.T) {
+	f := mustParseFilter(t, "")
+	assert.True(t, f.Match(&Update{Event: Event{Data: `{"score":1}`}}))
+}
+
+func TestFilterComparison(t *testing.T) {
+	f := mustParseFilter(t, "data.score>4")
+
+	assert.True(t, f.Match(&Update{Event: Event{Data: `{"score":5}`}}))
+	assert.False(t, f.Match(&Update{Event: Event{Data: `{"score":4}`}}))
+	assert.False(t, f.Match(&Update{Event: Event{Data: `{"score":3}`}}))
+}
+
+func TestFilterEventType(t *testing.T) {
+	f := mustParseFilter(t, "event.type=review")
+
+	assert.True(t, f.Match(&Update{Event: Event{Type: "review"}}))
+	assert.False(t, f.Match(&Update{Event: Event{Type: "comment"}}))
+}
+
+func TestFilterAndOr(t *testing.T) {
+	f := mustParseFilter(t, "event.type=review AND data.score>4")
+
+	assert.True(t, f.Match(&Update{Event: Event{Type: "review", Data: `{"score":5}`}}))
+	assert.False(t, f.Match(&Update{Event: Event{Type: "review", Data: `{"score":1}`}}))
+	assert.False(t, f.Match(&Update{Event: Event{Type: "comment", Data: `{"score":5}`}}))
+
+	f = mustParseFilter(t, "event.type=review OR event.type=comment")
+	assert.True(t, f.Match(&Update{Event: Event{Type: "comment"}}))
+	assert.False(t, f.Match(&Update{Event: Event{Type: "like"}}))
+}
+
+func TestFilterNotAndParens(t *testing.T) {
+	f := mustParseFilter(t, "NOT (event.type=review)")
+
+	assert.False(t, f.Match(&Update{Event: Event{Type: "review"}}))
+	assert.True(t, f.Match(&Update{Event: Event{Type: "comment"}}))
+}
+
+func TestFilterContains(t *testing.T) {
+	f := mustParseFilter(t, `data.tags CONTAINS "urgent"`)
+
+	assert.True(t, f.Match(&Update{Event: Event{Data: `{"tags":"urgent, review"}`}}))
+	assert.False(t, f.Match(&Update{Event: Event{Data: `{"tags":"review"}`}}))
+}
+
+func TestFilterContainsQuotedValueWithSpaces(t *testing.T) {
+	f := mustParseFilter(t, `data.title CONTAINS "hello world"`)
+
+	assert.True(t, f.Match(&Update{Event: Event{Data: `{"title":"hello world tour"}`}}))
+	assert.False(t, f.Match(&Update{Event: Event{Data: `{"title":"hello"}`}}))
+}
+
+func TestFilterExists(t *testing.T) {
+	f := mustParseFilter(t, "data.score EXISTS")
+
+	assert.True(t, f.Match(&Update{Event: Event{Data: `{"score":1}`}}))
+	assert.False(t, f.Match(&Update{Event: Event{Data: `{}`}}))
+}
+
+func TestFilterParseError(t *testing.T) {
+	_, err := ParseFilter("data.score >>> 4")
+	assert.Error(t, err)
+
+	_, err = ParseFilter("(data.score>4")
+	assert.Error(t, err)
+}
+
+// TestSubscribeInvalidFilter asserts that a malformed "filter" parameter is rejected at subscribe
+// time with a 400, analogous to TestSubscribeNoTopic.
+func TestSubscribeInvalidFilter(t *testing.T) {
+	hub := createAnonymousDummy()
+
+	req := httptest.NewRequest("GET", defaultHubURL+"?topic=foo&filter=NOT+%28data.score%3E4", nil)
+	w := httptest.NewRecorder()
+
+	hub.SubscribeHandler(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, 400, resp.StatusCode)
+}
+
+// TestSubscribeWithFilterNarrowsDelivery asserts that, with a filter set, only updates matching
+// both the topic and the filter are delivered to the subscriber.
+func TestSubscribeWithFilterNarrowsDelivery(t *testing.T) {
+	hub := createAnonymousDummy()
+
+	s, _ := hub.transport.(*LocalTransport)
+
+	go func() {
+		for {
+			s.RLock()
+			ready := len(s.subscribers) == 1
+			s.RUnlock()
+
+			if !ready {
+				continue
+			}
+
+			hub.transport.Dispatch(&Update{
+				Topics: []string{"http://example.com/reviews/21"},
+				Event:  Event{Data: `{"score":1}`, ID: "a"},
+			})
+			hub.transport.Dispatch(&Update{
+				Topics: []string{"http://example.com/reviews/22"},
+				Event:  Event{Data: `{"score":5}`, ID: "b"},
+			})
+
+			return
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest("GET", defaultHubURL+"?topic=http://example.com/reviews/{id}&filter=data.score%3E4", nil).WithContext(ctx)
+
+	w := &responseTester{
+		expectedStatusCode: 200,
+		expectedBody:       ":\nid: b\ndata: {\"score\":5}\n\n",
+		t:                  t,
+		cancel:             cancel,
+	}
+
+	hub.SubscribeHandler(w, req)
+	hub.Stop()
+}
@@ -0,0 +1,251 @@
+package hub
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/Grand-Angle/mercure/proto/mercure"
+)
+
+// GRPCServer implements the Mercure gRPC service, exposing the same subscribe/publish semantics
+// as SubscribeHandler/PublishHandler over streaming RPCs instead of SSE, against the same
+// transport, JWT and authorization configuration.
+type GRPCServer struct {
+	pb.UnimplementedMercureServer
+
+	hub *Hub
+}
+
+// NewGRPCServer creates a GRPCServer backed by h.
+func NewGRPCServer(h *Hub) *GRPCServer {
+	return &GRPCServer{hub: h}
+}
+
+// NewGRPCListener builds a *grpc.Server exposing h's Subscribe/Publish RPCs and a net.Listener
+// bound to the configured "grpc_addr", wiring TLS when "grpc_tls_cert_file" and
+// "grpc_tls_key_file" are both set. It returns a nil server and listener when "grpc_addr" isn't
+// configured, so hosting processes can treat gRPC as an optional transport alongside SSE.
+func NewGRPCListener(h *Hub) (*grpc.Server, net.Listener, error) {
+	addr := h.config.GetString("grpc_addr")
+	if addr == "" {
+		return nil, nil, nil
+	}
+
+	var opts []grpc.ServerOption
+
+	certFile := h.config.GetString("grpc_tls_cert_file")
+	keyFile := h.config.GetString("grpc_tls_key_file")
+
+	switch {
+	case certFile != "" && keyFile != "":
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("hub: loading gRPC TLS certificate: %w", err)
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}})))
+	case certFile != "" || keyFile != "":
+		return nil, nil, fmt.Errorf("hub: grpc_tls_cert_file and grpc_tls_key_file must be set together")
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s := grpc.NewServer(opts...)
+	pb.RegisterMercureServer(s, NewGRPCServer(h))
+
+	return s, lis, nil
+}
+
+// Subscribe streams updates matching req's topics and filter to the caller, mirroring
+// SubscribeHandler: JWT authentication via the "authorization" metadata entry, authorization,
+// Last-Event-ID replay, subscription lifecycle events and heartbeats are all handled the same way
+// as the HTTP transport. A zero-value Event is streamed on every heartbeat tick, mirroring the
+// ":\n" SSE comment SubscribeHandler writes.
+func (g *GRPCServer) Subscribe(req *pb.SubscribeRequest, stream pb.Mercure_SubscribeServer) error {
+	h := g.hub
+	ctx := stream.Context()
+
+	c, err := h.authenticateGRPC(ctx, subscriberRole)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	topics := req.GetTopic()
+	if len(topics) == 0 {
+		return status.Error(codes.InvalidArgument, `missing "topic" parameter`)
+	}
+
+	if h.authorizer != nil {
+		decision, err := h.authorizer.Authorize(subjectFromClaims(c), peerAddr(ctx), authorizeSubscribe, topics)
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+
+		if !decision.Allowed {
+			return status.Error(codes.PermissionDenied, decision.Reason)
+		}
+
+		if len(decision.AllowedTopics) > 0 {
+			topics = decision.AllowedTopics
+		}
+	}
+
+	filter, err := ParseFilter(req.GetFilter())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter: %s", err)
+	}
+
+	s := NewSubscriber(req.GetLastEventId(), h.subscriberCapacity(), h.subscriberEvictionGracePeriod(), h.config.GetBool("debug"))
+	s.Claims = c
+	s.Topics = topics
+	s.RawTopics = topics
+	s.Filter = filter
+
+	if err := h.transport.AddSubscriber(s); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer s.Disconnect()
+
+	if h.config.GetBool("dispatch_subscriptions") {
+		h.dispatchSubscriptionUpdates(s, true)
+		defer h.dispatchSubscriptionUpdates(s, false)
+	}
+
+	var heartbeat <-chan time.Time
+	if interval := h.config.GetDuration("heartbeat_interval"); interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.Disconnected():
+			return s.Err()
+		case <-heartbeat:
+			if err := stream.Send(&pb.Event{}); err != nil {
+				return err
+			}
+		case u := <-s.Receive():
+			if err := stream.Send(eventToProto(u.Event)); err != nil {
+				return err
+			}
+
+			s.SetLastEventID(u.Event.ID)
+		}
+	}
+}
+
+// Publish dispatches req's update to the hub's transport, gated by the same publisher-role JWT
+// and authorization logic as PublishHandler.
+func (g *GRPCServer) Publish(ctx context.Context, req *pb.PublishRequest) (*pb.PublishAck, error) {
+	h := g.hub
+
+	c, err := h.authenticateGRPC(ctx, publisherRole)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	topics := req.GetTopic()
+	if len(topics) == 0 {
+		return nil, status.Error(codes.InvalidArgument, `missing "topic" parameter`)
+	}
+
+	if h.authorizer != nil {
+		decision, err := h.authorizer.Authorize(subjectFromClaims(c), peerAddr(ctx), authorizePublish, topics)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+
+		if !decision.Allowed {
+			return nil, status.Error(codes.PermissionDenied, decision.Reason)
+		}
+	}
+
+	u := &Update{
+		Topics:  topics,
+		Private: req.GetPrivate(),
+		Event:   eventFromProto(req.GetEvent()),
+	}
+
+	if err := h.transport.Dispatch(u); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return &pb.PublishAck{Id: u.Event.ID}, nil
+}
+
+// authenticateGRPC extracts and validates the JWT carried in ctx's "authorization" metadata entry
+// for the given role, applying the same open-hub fallback as the HTTP authenticate.
+func (h *Hub) authenticateGRPC(ctx context.Context, ro role) (*claims, error) {
+	token, err := tokenFromMetadata(ctx)
+
+	return h.authenticateToken(token, err, ro)
+}
+
+// tokenFromMetadata extracts the bearer token from ctx's incoming "authorization" metadata entry,
+// the gRPC equivalent of the Authorization HTTP header.
+func tokenFromMetadata(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errNoJWT
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", errNoJWT
+	}
+
+	authorizationHeader := values[0]
+	if len(authorizationHeader) < len(bearerPrefix) || authorizationHeader[:len(bearerPrefix)] != bearerPrefix {
+		return "", errInvalidJWT
+	}
+
+	return authorizationHeader[len(bearerPrefix):], nil
+}
+
+// peerAddr returns the remote address of the gRPC client, for reporting to the external
+// authorizer the same way r.RemoteAddr is on the HTTP transport.
+func peerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	return p.Addr.String()
+}
+
+// eventToProto converts a hub Event to its gRPC wire representation.
+func eventToProto(e Event) *pb.Event {
+	return &pb.Event{
+		Id:    e.ID,
+		Type:  e.Type,
+		Retry: int64(e.Retry),
+		Data:  e.Data,
+	}
+}
+
+// eventFromProto converts a gRPC Event back to a hub Event.
+func eventFromProto(e *pb.Event) Event {
+	return Event{
+		ID:    e.GetId(),
+		Type:  e.GetType(),
+		Retry: uint64(e.GetRetry()),
+		Data:  e.GetData(),
+	}
+}
@@ -0,0 +1,226 @@
+package hub
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/Grand-Angle/mercure/proto/mercure"
+)
+
+// startGRPCHub creates an anonymous dummy hub with gRPC enabled on a random local port, serves it
+// in the background, and returns a client connected to it alongside a cleanup function.
+func startGRPCHub(t *testing.T) (*Hub, pb.MercureClient, func()) {
+	t.Helper()
+
+	h := createAnonymousDummy()
+	h.config.Set("grpc_addr", "127.0.0.1:0")
+
+	server, lis, err := NewGRPCListener(h)
+	require.NoError(t, err)
+	require.NotNil(t, server)
+
+	go server.Serve(lis)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	return h, pb.NewMercureClient(conn), func() {
+		conn.Close()
+		server.Stop()
+		h.Stop()
+	}
+}
+
+// waitForSubscribers blocks until the hub's LocalTransport has exactly n registered subscribers.
+func waitForSubscribers(h *Hub, n int) {
+	t, _ := h.transport.(*LocalTransport)
+
+	for {
+		t.RLock()
+		ready := len(t.subscribers) == n
+		t.RUnlock()
+
+		if ready {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// generateSelfSignedCert writes a self-signed certificate and private key for "127.0.0.1" to PEM
+// files under t.TempDir() and returns their paths, for exercising NewGRPCListener's TLS wiring.
+func generateSelfSignedCert(t *testing.T) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	certFile = filepath.Join(dir, "cert.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certFile, keyFile
+}
+
+func TestGRPCListenerServesOverTLS(t *testing.T) {
+	certFile, keyFile := generateSelfSignedCert(t)
+
+	h := createAnonymousDummy()
+	h.config.Set("grpc_addr", "127.0.0.1:0")
+	h.config.Set("grpc_tls_cert_file", certFile)
+	h.config.Set("grpc_tls_key_file", keyFile)
+
+	server, lis, err := NewGRPCListener(h)
+	require.NoError(t, err)
+	require.NotNil(t, server)
+
+	go server.Serve(lis)
+	defer func() {
+		server.Stop()
+		h.Stop()
+	}()
+
+	pool := x509.NewCertPool()
+	certPEM, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	require.True(t, pool.AppendCertsFromPEM(certPEM))
+
+	creds := credentials.NewTLS(&tls.Config{RootCAs: pool, ServerName: "127.0.0.1"})
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewMercureClient(conn)
+
+	// The missing topic is only reported once the TLS handshake succeeded and the RPC reached the
+	// server; a handshake failure would surface as Unavailable instead.
+	_, err = client.Publish(context.Background(), &pb.PublishRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCPublishDeliveredOverSSE(t *testing.T) {
+	h, client, cleanup := startGRPCHub(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest("GET", defaultHubURL+"?topic=http://example.com/reviews/21", nil).WithContext(ctx)
+
+	w := &responseTester{
+		expectedStatusCode: 200,
+		expectedBody:       ":\nid: a\ndata: hello from grpc\n\n",
+		t:                  t,
+		cancel:             cancel,
+	}
+
+	published := make(chan error, 1)
+
+	go func() {
+		waitForSubscribers(h, 1)
+
+		_, err := client.Publish(context.Background(), &pb.PublishRequest{
+			Topic: []string{"http://example.com/reviews/21"},
+			Event: &pb.Event{Id: "a", Data: "hello from grpc"},
+		})
+		published <- err
+	}()
+
+	h.SubscribeHandler(w, req)
+
+	assert.NoError(t, <-published)
+}
+
+func TestGRPCSubscribeReceivesHTTPPublish(t *testing.T) {
+	h, client, cleanup := startGRPCHub(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.Subscribe(ctx, &pb.SubscribeRequest{Topic: []string{"http://example.com/reviews/22"}})
+	require.NoError(t, err)
+
+	waitForSubscribers(h, 1)
+
+	req := httptest.NewRequest("POST", defaultHubURL, strings.NewReader(url.Values{
+		"topic": {"http://example.com/reviews/22"},
+		"id":    {"b"},
+		"data":  {"hello from sse"},
+	}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	w := httptest.NewRecorder()
+	h.PublishHandler(w, req)
+	assert.Equal(t, 200, w.Result().StatusCode)
+
+	event, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "b", event.GetId())
+	assert.Equal(t, "hello from sse", event.GetData())
+}
+
+func TestGRPCSubscribeMissingTopic(t *testing.T) {
+	_, client, cleanup := startGRPCHub(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	stream, err := client.Subscribe(ctx, &pb.SubscribeRequest{})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Error(t, err)
+}
+
+func TestGRPCPublishMissingTopic(t *testing.T) {
+	_, client, cleanup := startGRPCHub(t)
+	defer cleanup()
+
+	_, err := client.Publish(context.Background(), &pb.PublishRequest{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,77 @@
+package hub
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Hub stores subscribers, and allows dispatching updates to them while enforcing authorization.
+type Hub struct {
+	transport  Transport
+	config     *viper.Viper
+	metrics    *Metrics
+	jwtConfig  jwtConfig
+	authorizer *Authorizer
+}
+
+// NewHub creates a new Hub, using transport to dispatch updates and config for its settings.
+func NewHub(transport Transport, config *viper.Viper) *Hub {
+	h := &Hub{
+		transport: transport,
+		config:    config,
+		metrics:   NewMetrics(),
+		jwtConfig: jwtConfig{
+			publisherKey:  []byte(config.GetString("publisher_jwt_key")),
+			subscriberKey: []byte(config.GetString("subscriber_jwt_key")),
+		},
+	}
+
+	if u := config.GetString("authorizer_url"); u != "" {
+		h.authorizer = NewAuthorizer(u, config.GetDuration("authorizer_cache_ttl"))
+	}
+
+	return h
+}
+
+// Stop closes the hub's transport, disconnecting every currently connected subscriber.
+func (h *Hub) Stop() error {
+	return h.transport.Close()
+}
+
+// observerRegistry is implemented by transports that support Observers (both LocalTransport and
+// BoltTransport do).
+type observerRegistry interface {
+	RegisterObserver(o Observer)
+	DeregisterObserver(o Observer)
+}
+
+// RegisterObserver registers o to be notified, off the SSE hot path, of every update dispatched
+// by the hub's transport. It is a no-op if the configured transport doesn't support observers.
+func (h *Hub) RegisterObserver(o Observer) {
+	if r, ok := h.transport.(observerRegistry); ok {
+		r.RegisterObserver(o)
+	}
+}
+
+// DeregisterObserver removes a previously registered Observer.
+func (h *Hub) DeregisterObserver(o Observer) {
+	if r, ok := h.transport.(observerRegistry); ok {
+		r.DeregisterObserver(o)
+	}
+}
+
+// subscriberCapacity returns the configured per-subscriber outbound buffer size.
+func (h *Hub) subscriberCapacity() int {
+	if !h.config.IsSet("subscriber_capacity") {
+		return defaultSubscriberCapacity
+	}
+
+	return h.config.GetInt("subscriber_capacity")
+}
+
+// subscriberEvictionGracePeriod returns how long a subscriber's outbound buffer may stay full
+// before it gets evicted as a slow consumer.
+func (h *Hub) subscriberEvictionGracePeriod() time.Duration {
+	return h.config.GetDuration("subscriber_eviction_grace_period")
+}
@@ -0,0 +1,86 @@
+package hub
+
+import (
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultHubURL         = "http://example.com/hub"
+	dummySubscriberJWTKey = "subscriberKey!!!!!!!!!!!!!!!!!!"
+	dummyPublisherJWTKey  = "publisherKey!!!!!!!!!!!!!!!!!!!"
+	wrongJWTKey           = "wrongKeyWrongKeyWrongKeyWrongKe"
+)
+
+// createDummy creates a Hub backed by a LocalTransport, requiring a valid JWT to subscribe or publish.
+func createDummy() *Hub {
+	config := NewViper()
+	config.Set("subscriber_jwt_key", dummySubscriberJWTKey)
+	config.Set("publisher_jwt_key", dummyPublisherJWTKey)
+	config.Set("subscriber_eviction_grace_period", time.Second)
+
+	return createDummyWithTransportAndConfig(NewLocalTransport(NewMetrics()), config)
+}
+
+// createAnonymousDummy creates a Hub backed by a LocalTransport, with no JWT key configured, so
+// every subscriber is implicitly authorized.
+func createAnonymousDummy() *Hub {
+	config := NewViper()
+	config.Set("subscriber_eviction_grace_period", time.Second)
+
+	return createDummyWithTransportAndConfig(NewLocalTransport(NewMetrics()), config)
+}
+
+// createDummyWithTransportAndConfig creates a Hub using the given transport and config.
+func createDummyWithTransportAndConfig(transport Transport, config *viper.Viper) *Hub {
+	if !config.IsSet("subscriber_eviction_grace_period") {
+		config.Set("subscriber_eviction_grace_period", time.Second)
+	}
+
+	return NewHub(transport, config)
+}
+
+// createDummyAuthorizedJWT creates a JWT, signed with the hub's configured key for ro, granting
+// access to topics.
+func createDummyAuthorizedJWT(h *Hub, ro role, topics []string) string {
+	key := h.jwtConfig.subscriberKey
+	if ro == publisherRole {
+		key = h.jwtConfig.publisherKey
+	}
+
+	c := &claims{}
+	if ro == publisherRole {
+		c.Mercure.Publish = topics
+	} else {
+		c.Mercure.Subscribe = topics
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return token
+}
+
+// createDummyUnauthorizedJWT creates a well-formed JWT signed with a key the hub doesn't trust.
+func createDummyUnauthorizedJWT() string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims{}).SignedString([]byte(wrongJWTKey))
+	if err != nil {
+		panic(err)
+	}
+
+	return token
+}
+
+// createDummyNoneSignedJWT creates a JWT using the "none" signing method, which must always be rejected.
+func createDummyNoneSignedJWT() string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodNone, &claims{}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		panic(err)
+	}
+
+	return token
+}
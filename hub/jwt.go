@@ -0,0 +1,64 @@
+package hub
+
+import (
+	"errors"
+	"fmt"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// role identifies which side of a JWT (publisher or subscriber) is being validated.
+type role int
+
+const (
+	subscriberRole role = iota
+	publisherRole
+)
+
+// mercureClaim is the content of the "mercure" claim of a Mercure JWT.
+type mercureClaim struct {
+	Publish   []string    `json:"publish"`
+	Subscribe []string    `json:"subscribe"`
+	Payload   interface{} `json:"payload,omitempty"`
+}
+
+// claims is the expected content of a Mercure JWT.
+type claims struct {
+	jwt.StandardClaims
+	Mercure mercureClaim `json:"mercure"`
+}
+
+var errUnexpectedSigningMethod = errors.New("unexpected signing method")
+
+// validateJWT validates the JWT found in the request and returns its claims.
+func validateJWT(encodedToken string, r role, config jwtConfig) (*claims, error) {
+	token, err := jwt.ParseWithClaims(encodedToken, &claims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+				return nil, fmt.Errorf("%w: %v", errUnexpectedSigningMethod, token.Header["alg"])
+			}
+		}
+
+		if r == publisherRole {
+			return config.publisherKey, nil
+		}
+
+		return config.subscriberKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c, ok := token.Claims.(*claims)
+	if !ok || !token.Valid {
+		return nil, errInvalidJWT
+	}
+
+	return c, nil
+}
+
+// jwtConfig carries the keys used to validate publisher and subscriber JWTs.
+type jwtConfig struct {
+	publisherKey  []byte
+	subscriberKey []byte
+}
@@ -0,0 +1,124 @@
+package hub
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// LocalTransport implements the Transport interface without any persistence support: updates
+// are only ever seen by subscribers connected at dispatch time.
+type LocalTransport struct {
+	sync.RWMutex
+	subscribers map[*Subscriber]struct{}
+	metrics     *Metrics
+	observable  *observable
+	closed      chan struct{}
+	closeOnce   sync.Once
+}
+
+// NewLocalTransport creates a new LocalTransport.
+func NewLocalTransport(metrics *Metrics) *LocalTransport {
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &LocalTransport{
+		subscribers: make(map[*Subscriber]struct{}),
+		metrics:     metrics,
+		observable:  newObservable(metrics),
+		closed:      make(chan struct{}),
+	}
+}
+
+// RegisterObserver registers o to be notified of every update this transport dispatches, on a
+// goroutine dedicated to observers so a slow one never delays SSE delivery.
+func (t *LocalTransport) RegisterObserver(o Observer) {
+	t.observable.register(o)
+}
+
+// DeregisterObserver removes a previously registered Observer.
+func (t *LocalTransport) DeregisterObserver(o Observer) {
+	t.observable.deregister(o)
+}
+
+// AddSubscriber adds a new subscriber to the list of subscribers, and starts monitoring its
+// outbound buffer so a slow consumer gets evicted instead of blocking Dispatch. It is pruned from
+// the subscriber set once it disconnects, so the transport's memory usage stays proportional to
+// concurrent connections rather than growing with every connection the hub has ever seen.
+func (t *LocalTransport) AddSubscriber(s *Subscriber) error {
+	select {
+	case <-t.closed:
+		return errTransportClosed
+	default:
+	}
+
+	t.Lock()
+	t.subscribers[s] = struct{}{}
+	t.Unlock()
+
+	go s.watchCapacity(func(s *Subscriber) {
+		t.metrics.SubscriberEvicted()
+		log.WithField("subscriber", s.ID).Warn("evicting slow subscriber: outbound buffer stayed full past the grace period")
+	})
+
+	go t.reapSubscriber(s)
+
+	return nil
+}
+
+// reapSubscriber removes s from the subscriber set once it disconnects, whether cleanly or
+// through eviction.
+func (t *LocalTransport) reapSubscriber(s *Subscriber) {
+	<-s.Disconnected()
+
+	t.Lock()
+	delete(t.subscribers, s)
+	t.Unlock()
+}
+
+// Dispatch fans the update out in two phases: first (synchronously) to matching HTTP SSE
+// subscribers, never blocking on any single slow one, then (asynchronously, on the observable's
+// dedicated goroutine) to every registered Observer. Expensive per-event side-effects like
+// indexing or analytics belong behind an Observer, not on this hot path.
+func (t *LocalTransport) Dispatch(update *Update) error {
+	select {
+	case <-t.closed:
+		return errTransportClosed
+	default:
+	}
+
+	t.RLock()
+	for s := range t.subscribers {
+		select {
+		case <-s.Disconnected():
+			continue
+		default:
+		}
+
+		s.Dispatch(update)
+	}
+	t.RUnlock()
+
+	t.observable.notify(update)
+
+	return nil
+}
+
+// Close closes the transport, disconnecting every currently connected subscriber and stopping
+// the observer fan-out goroutine.
+func (t *LocalTransport) Close() error {
+	t.closeOnce.Do(func() {
+		close(t.closed)
+
+		t.Lock()
+		for s := range t.subscribers {
+			s.Disconnect()
+		}
+		t.Unlock()
+
+		t.observable.stop()
+	})
+
+	return nil
+}
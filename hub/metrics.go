@@ -0,0 +1,52 @@
+package hub
+
+import "sync/atomic"
+
+// Metrics gathers counters about hub activity. It is intentionally dependency-free so it can be
+// read from a Prometheus collector, logged, or ignored.
+type Metrics struct {
+	subscribersEvicted    uint64
+	observerUpdateDropped uint64
+}
+
+// NewMetrics creates a new, zeroed Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// SubscriberEvicted increments the subscribers_evicted_total counter.
+func (m *Metrics) SubscriberEvicted() {
+	if m == nil {
+		return
+	}
+
+	atomic.AddUint64(&m.subscribersEvicted, 1)
+}
+
+// SubscribersEvictedTotal returns the current value of the subscribers_evicted_total counter.
+func (m *Metrics) SubscribersEvictedTotal() uint64 {
+	if m == nil {
+		return 0
+	}
+
+	return atomic.LoadUint64(&m.subscribersEvicted)
+}
+
+// ObserverUpdateDropped increments the observer_updates_dropped_total counter.
+func (m *Metrics) ObserverUpdateDropped() {
+	if m == nil {
+		return
+	}
+
+	atomic.AddUint64(&m.observerUpdateDropped, 1)
+}
+
+// ObserverUpdatesDroppedTotal returns the current value of the observer_updates_dropped_total
+// counter.
+func (m *Metrics) ObserverUpdatesDroppedTotal() uint64 {
+	if m == nil {
+		return 0
+	}
+
+	return atomic.LoadUint64(&m.observerUpdateDropped)
+}
@@ -0,0 +1,104 @@
+package hub
+
+import "sync"
+
+// Observer receives every update dispatched by the hub, independently of which (if any) HTTP
+// subscribers are currently connected. Observe must not block for long: observers run on a
+// dedicated goroutine off the request path, but a slow observer still delays the ones queued
+// behind it.
+type Observer interface {
+	Observe(u *Update)
+}
+
+// observable fans out updates to registered Observers on a dedicated goroutine, so that expensive
+// per-event side-effects (indexing, mirroring, analytics) never slow down SSE delivery to
+// subscribers. It is safe for concurrent use.
+type observable struct {
+	mu        sync.RWMutex
+	observers []Observer
+
+	metrics *Metrics
+	updates chan *Update
+	done    chan struct{}
+	closed  chan struct{}
+	once    sync.Once
+}
+
+// newObservable creates an observable and starts its fan-out goroutine. Dropped updates (see
+// notify) are counted on metrics, which may be nil.
+func newObservable(metrics *Metrics) *observable {
+	o := &observable{
+		metrics: metrics,
+		updates: make(chan *Update, 256),
+		done:    make(chan struct{}),
+		closed:  make(chan struct{}),
+	}
+
+	go o.run()
+
+	return o
+}
+
+// register adds an Observer to the list notified of every future update.
+func (o *observable) register(obs Observer) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.observers = append(o.observers, obs)
+}
+
+// deregister removes a previously registered Observer. It is a no-op if obs isn't registered.
+func (o *observable) deregister(obs Observer) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i, existing := range o.observers {
+		if existing == obs {
+			// Copy into a fresh slice: run may still be iterating the old one outside the lock.
+			o.observers = append(o.observers[:i:i], o.observers[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// notify enqueues u for delivery to every registered Observer. It never blocks the caller, not
+// even on the internal queue between Dispatch and the fan-out goroutine: if that queue is
+// saturated, meaning observers have fallen arbitrarily far behind, the update is dropped for
+// observers (HTTP subscribers are unaffected) and counted towards observer_updates_dropped_total
+// rather than stalling Dispatch for every subscriber.
+func (o *observable) notify(u *Update) {
+	select {
+	case <-o.closed:
+	case o.updates <- u:
+	default:
+		o.metrics.ObserverUpdateDropped()
+	}
+}
+
+// run is the dedicated fan-out goroutine: it is the only place Observer.Observe is called from,
+// keeping that work off of Dispatch's caller.
+func (o *observable) run() {
+	for {
+		select {
+		case <-o.done:
+			return
+		case u := <-o.updates:
+			o.mu.RLock()
+			observers := o.observers
+			o.mu.RUnlock()
+
+			for _, obs := range observers {
+				obs.Observe(u)
+			}
+		}
+	}
+}
+
+// stop shuts down the fan-out goroutine. Safe to call more than once.
+func (o *observable) stop() {
+	o.once.Do(func() {
+		close(o.closed)
+		close(o.done)
+	})
+}
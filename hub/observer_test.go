@@ -0,0 +1,141 @@
+package hub
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type funcObserver struct {
+	fn func(*Update)
+}
+
+func (o *funcObserver) Observe(u *Update) {
+	o.fn(u)
+}
+
+// TestObserverDoesNotDelaySubscribers registers a deliberately slow Observer and asserts that
+// SubscribeHandler still delivers the update to a connected subscriber promptly: observers run
+// off the request path and must never slow down SSE delivery.
+func TestObserverDoesNotDelaySubscribers(t *testing.T) {
+	hub := createAnonymousDummy()
+	defer hub.Stop()
+
+	slow := &funcObserver{fn: func(*Update) { time.Sleep(200 * time.Millisecond) }}
+	hub.RegisterObserver(slow)
+
+	s, _ := hub.transport.(*LocalTransport)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req := httptest.NewRequest("GET", defaultHubURL+"?topic=http://example.com/books/1", nil).WithContext(ctx)
+
+	received := make(chan struct{})
+	w := &responseTester{
+		expectedStatusCode: 200,
+		expectedBody:       ":\nid: a\ndata: Hello World\n\n",
+		cancel:             cancel,
+		t:                  t,
+	}
+
+	go func() {
+		hub.SubscribeHandler(w, req)
+		close(received)
+	}()
+
+	for {
+		s.RLock()
+		ready := len(s.subscribers) == 1
+		s.RUnlock()
+
+		if ready {
+			break
+		}
+	}
+
+	start := time.Now()
+	hub.transport.Dispatch(&Update{
+		Topics: []string{"http://example.com/books/1"},
+		Event:  Event{Data: "Hello World", ID: "a"},
+	})
+
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		t.Fatal("subscriber never received the update")
+	}
+
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+// TestObserverSustainedBackpressureDoesNotBlockDispatch registers an Observer that never drains
+// and dispatches enough updates to saturate the fan-out queue many times over, asserting that
+// Dispatch keeps returning promptly throughout: a single stuck observer must never stall delivery
+// to every subscriber across every topic, which would be worse than the slow-subscriber problem
+// observers exist to solve.
+func TestObserverSustainedBackpressureDoesNotBlockDispatch(t *testing.T) {
+	hub := createAnonymousDummy()
+	defer hub.Stop()
+
+	block := make(chan struct{})
+	defer close(block)
+
+	stuck := &funcObserver{fn: func(*Update) { <-block }}
+	hub.RegisterObserver(stuck)
+
+	start := time.Now()
+
+	for i := 0; i < 1000; i++ {
+		assert.NoError(t, hub.transport.Dispatch(&Update{Topics: []string{"t"}, Event: Event{ID: "a"}}))
+	}
+
+	assert.Less(t, time.Since(start), time.Second)
+
+	s, _ := hub.transport.(*LocalTransport)
+	assert.Greater(t, s.metrics.ObserverUpdatesDroppedTotal(), uint64(0))
+}
+
+// TestObserverRegistrationLifecycle exercises registering and deregistering an Observer, then
+// stopping the hub, asserting Observe is only called while truly registered and Stop succeeds.
+func TestObserverRegistrationLifecycle(t *testing.T) {
+	hub := createAnonymousDummy()
+
+	var calls int32
+	obs := &funcObserver{fn: func(*Update) { atomic.AddInt32(&calls, 1) }}
+
+	hub.RegisterObserver(obs)
+	assert.NoError(t, hub.transport.Dispatch(&Update{Topics: []string{"t"}, Event: Event{ID: "a"}}))
+	waitForObserverCalls(t, &calls, 1)
+
+	hub.DeregisterObserver(obs)
+	assert.NoError(t, hub.transport.Dispatch(&Update{Topics: []string{"t"}, Event: Event{ID: "b"}}))
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, hub.Stop())
+	}()
+	wg.Wait()
+}
+
+func waitForObserverCalls(t *testing.T, calls *int32, want int32) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if atomic.LoadInt32(calls) >= want {
+			return
+		}
+	}
+
+	t.Fatalf("observer was not called %d times in time", want)
+}
@@ -0,0 +1,78 @@
+package hub
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// CounterObserver is a built-in Observer that counts dispatched updates and exposes them in the
+// OpenMetrics text format, for scraping by Prometheus or any compatible collector.
+type CounterObserver struct {
+	count uint64
+}
+
+// NewCounterObserver creates a zeroed CounterObserver.
+func NewCounterObserver() *CounterObserver {
+	return &CounterObserver{}
+}
+
+// Observe implements Observer.
+func (c *CounterObserver) Observe(*Update) {
+	atomic.AddUint64(&c.count, 1)
+}
+
+// Count returns the number of updates observed so far.
+func (c *CounterObserver) Count() uint64 {
+	return atomic.LoadUint64(&c.count)
+}
+
+// WriteOpenMetrics writes the counter in the OpenMetrics text exposition format.
+func (c *CounterObserver) WriteOpenMetrics(w io.Writer) error {
+	_, err := fmt.Fprintf(w,
+		"# TYPE mercure_updates_total counter\nmercure_updates_total %d\n",
+		c.Count(),
+	)
+
+	return err
+}
+
+// IndexEntry is a single (topic, id, timestamp) tuple recorded for offline auditing.
+type IndexEntry struct {
+	Topic     string
+	ID        string
+	Timestamp time.Time
+}
+
+// IndexSink persists IndexEntry tuples somewhere durable (a file, a database, a message queue...).
+type IndexSink interface {
+	Index(e IndexEntry) error
+}
+
+// IndexObserver is a built-in Observer that writes a (topic, id, timestamp) tuple to a pluggable
+// IndexSink for every topic of every dispatched update, for offline auditing.
+type IndexObserver struct {
+	sink IndexSink
+	now  func() time.Time
+}
+
+// NewIndexObserver creates an IndexObserver writing to sink.
+func NewIndexObserver(sink IndexSink) *IndexObserver {
+	return &IndexObserver{sink: sink, now: time.Now}
+}
+
+// Observe implements Observer.
+func (o *IndexObserver) Observe(u *Update) {
+	now := o.now()
+
+	for _, topic := range u.Topics {
+		entry := IndexEntry{Topic: topic, ID: u.Event.ID, Timestamp: now}
+
+		if err := o.sink.Index(entry); err != nil {
+			log.WithError(err).WithField("topic", topic).Warn("failed to index update for auditing")
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package hub
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterObserverCountsAndWritesOpenMetrics(t *testing.T) {
+	c := NewCounterObserver()
+
+	assert.Equal(t, uint64(0), c.Count())
+
+	c.Observe(&Update{Topics: []string{"t"}, Event: Event{ID: "a"}})
+	c.Observe(&Update{Topics: []string{"t"}, Event: Event{ID: "b"}})
+
+	assert.Equal(t, uint64(2), c.Count())
+
+	var buf bytes.Buffer
+	assert.NoError(t, c.WriteOpenMetrics(&buf))
+	assert.Equal(t, "# TYPE mercure_updates_total counter\nmercure_updates_total 2\n", buf.String())
+}
+
+// fakeIndexSink is an IndexSink test double recording every entry it receives.
+type fakeIndexSink struct {
+	mu      sync.Mutex
+	entries []IndexEntry
+}
+
+func (s *fakeIndexSink) Index(e IndexEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = append(s.entries, e)
+
+	return nil
+}
+
+func TestIndexObserverRecordsOneEntryPerTopic(t *testing.T) {
+	sink := &fakeIndexSink{}
+	o := NewIndexObserver(sink)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	o.now = func() time.Time { return now }
+
+	o.Observe(&Update{
+		Topics: []string{"http://example.com/a", "http://example.com/b"},
+		Event:  Event{ID: "evt-1"},
+	})
+
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+
+	assert.Equal(t, []IndexEntry{
+		{Topic: "http://example.com/a", ID: "evt-1", Timestamp: now},
+		{Topic: "http://example.com/b", ID: "evt-1", Timestamp: now},
+	}, sink.entries)
+}
+
+// failingIndexSink is an IndexSink test double that always errors, to exercise the path where
+// IndexObserver must keep going (and keep informing the operator) instead of panicking or
+// stalling Dispatch.
+type failingIndexSink struct {
+	err error
+}
+
+func (s *failingIndexSink) Index(IndexEntry) error {
+	return s.err
+}
+
+func TestIndexObserverSurvivesFailingSink(t *testing.T) {
+	sink := &failingIndexSink{err: errors.New("index unavailable")}
+	o := NewIndexObserver(sink)
+
+	assert.NotPanics(t, func() {
+		o.Observe(&Update{
+			Topics: []string{"http://example.com/a", "http://example.com/b"},
+			Event:  Event{ID: "evt-1"},
+		})
+	})
+}
@@ -0,0 +1,63 @@
+package hub
+
+import (
+	"net/http"
+)
+
+// PublishHandler handles publication requests, dispatching the submitted update to subscribers
+// through the hub's transport.
+func (h *Hub) PublishHandler(w http.ResponseWriter, r *http.Request) {
+	c, err := h.authenticate(r, publisherRole)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+
+		return
+	}
+
+	topics := r.PostForm["topic"]
+	if len(topics) == 0 {
+		http.Error(w, `Missing "topic" parameter.`, http.StatusBadRequest)
+
+		return
+	}
+
+	if h.authorizer != nil {
+		decision, err := h.authorizer.Authorize(subjectFromClaims(c), r.RemoteAddr, authorizePublish, topics)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+
+			return
+		}
+
+		if !decision.Allowed {
+			http.Error(w, decision.Reason, http.StatusForbidden)
+
+			return
+		}
+	}
+
+	u := &Update{
+		Topics:  topics,
+		Private: r.PostForm.Get("private") == "on",
+		Event: Event{
+			Data: r.PostForm.Get("data"),
+			Type: r.PostForm.Get("type"),
+			ID:   r.PostForm.Get("id"),
+		},
+	}
+
+	if err := h.transport.Dispatch(u); err != nil {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(u.Event.ID))
+}
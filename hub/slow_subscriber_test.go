@@ -0,0 +1,74 @@
+package hub
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSlowSubscriberEviction publishes updates faster than a slow subscriber can drain its
+// outbound buffer, and asserts that Dispatch never blocks on it: fast subscribers still receive
+// every update, while the slow one gets cleanly evicted with errSubscriberOutOfCapacity.
+func TestSlowSubscriberEviction(t *testing.T) {
+	metrics := NewMetrics()
+	transport := NewLocalTransport(metrics)
+	defer transport.Close()
+
+	const topic = "http://example.com/books/1"
+	const updates = 200
+
+	// Large enough that the fast subscriber's buffer can hold every update regardless of how
+	// quickly the reading goroutine below gets scheduled, so this test only exercises whether
+	// Dispatch ever blocks on the slow subscriber.
+	fast := NewSubscriber("", updates, 50*time.Millisecond, false)
+	fast.Topics = []string{topic}
+
+	slow := NewSubscriber("", 2, 50*time.Millisecond, false)
+	slow.Topics = []string{topic}
+
+	if !assert.NoError(t, transport.AddSubscriber(fast)) {
+		return
+	}
+	if !assert.NoError(t, transport.AddSubscriber(slow)) {
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	received := 0
+	go func() {
+		defer wg.Done()
+
+		for received < updates {
+			select {
+			case <-fast.Receive():
+				received++
+			case <-fast.Disconnected():
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < updates; i++ {
+		assert.NoError(t, transport.Dispatch(&Update{
+			Topics: []string{topic},
+			Event:  Event{ID: strconv.Itoa(i), Data: "x"},
+		}))
+	}
+
+	wg.Wait()
+	assert.Equal(t, updates, received)
+
+	select {
+	case <-slow.Disconnected():
+	case <-time.After(time.Second):
+		t.Fatal("slow subscriber was never evicted")
+	}
+
+	assert.Equal(t, errSubscriberOutOfCapacity, slow.Err())
+	assert.Equal(t, uint64(1), metrics.SubscribersEvictedTotal())
+}
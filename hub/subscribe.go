@@ -0,0 +1,220 @@
+package hub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const subscriptionsTopicPrefix = "/.well-known/mercure/subscriptions/"
+
+// SubscribeHandler handles subscription requests, registering a new Subscriber with the hub's
+// transport and streaming matching updates back to the client as Server-Sent Events until the
+// client disconnects or the subscriber gets evicted.
+func (h *Hub) SubscribeHandler(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		panic("http.ResponseWriter must be an instance of http.Flusher")
+	}
+
+	c, err := h.authenticate(r, subscriberRole)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+
+		return
+	}
+
+	topics := r.URL.Query()["topic"]
+	if len(topics) == 0 {
+		http.Error(w, `Missing "topic" parameter.`, http.StatusBadRequest)
+
+		return
+	}
+
+	if h.authorizer != nil {
+		decision, err := h.authorizer.Authorize(subjectFromClaims(c), r.RemoteAddr, authorizeSubscribe, topics)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+
+			return
+		}
+
+		if !decision.Allowed {
+			http.Error(w, decision.Reason, http.StatusForbidden)
+
+			return
+		}
+
+		if len(decision.AllowedTopics) > 0 {
+			topics = decision.AllowedTopics
+		}
+	}
+
+	filter, err := ParseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid \"filter\" parameter: %s.", err), http.StatusBadRequest)
+
+		return
+	}
+
+	s := NewSubscriber(lastEventID(r), h.subscriberCapacity(), h.subscriberEvictionGracePeriod(), h.config.GetBool("debug"))
+	s.Claims = c
+	s.Topics = topics
+	s.RawTopics = topics
+	s.Filter = filter
+
+	if err := h.transport.AddSubscriber(s); err != nil {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+
+		return
+	}
+	defer s.Disconnect()
+
+	if h.config.GetBool("dispatch_subscriptions") {
+		h.dispatchSubscriptionUpdates(s, true)
+		defer h.dispatchSubscriptionUpdates(s, false)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	fmt.Fprint(w, ":\n")
+	flusher.Flush()
+
+	var heartbeat <-chan time.Time
+	if interval := h.config.GetDuration("heartbeat_interval"); interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.Disconnected():
+			return
+		case <-heartbeat:
+			fmt.Fprint(w, ":\n")
+			flusher.Flush()
+		case u := <-s.Receive():
+			writeEvent(w, u.Event)
+			s.SetLastEventID(u.Event.ID)
+			flusher.Flush()
+		}
+	}
+}
+
+// authenticate extracts and validates the JWT used to authenticate the request for the given
+// role. A hub with no key configured for that role is considered open: a request from which no
+// JWT can be extracted is granted full access, while a JWT that is present is still validated.
+func (h *Hub) authenticate(r *http.Request, ro role) (*claims, error) {
+	allowCookie := ro != publisherRole
+
+	token, err := extractJWT(r, allowCookie)
+
+	return h.authenticateToken(token, err, ro)
+}
+
+// authenticateToken validates token for the given role, or, when tokenErr is non-nil (no token
+// could be extracted), falls back to the same open-hub behaviour as authenticate: a hub with no
+// key configured for that role grants full access, otherwise tokenErr is returned as-is. It is
+// shared by the HTTP and gRPC subscribe/publish entry points so both transports enforce the exact
+// same JWT rules.
+func (h *Hub) authenticateToken(token string, tokenErr error, ro role) (*claims, error) {
+	if tokenErr != nil {
+		key := h.jwtConfig.subscriberKey
+		if ro == publisherRole {
+			key = h.jwtConfig.publisherKey
+		}
+
+		if len(key) == 0 {
+			return &claims{Mercure: mercureClaim{Subscribe: []string{"*"}, Publish: []string{"*"}}}, nil
+		}
+
+		return nil, tokenErr
+	}
+
+	return validateJWT(token, ro, h.jwtConfig)
+}
+
+// subjectFromClaims returns the subject to report to the external authorizer: the JWT's
+// "sub" claim when present, or "anonymous" for an unauthenticated request.
+func subjectFromClaims(c *claims) string {
+	if c == nil || c.Subject == "" {
+		return "anonymous"
+	}
+
+	return c.Subject
+}
+
+// lastEventID returns the event ID the client wants to resume from, looking first at the
+// Last-Event-ID header (the spec-compliant way), then at the Last-Event-ID query parameter
+// (useful when the client can't set custom headers, e.g. EventSource in a browser).
+func lastEventID(r *http.Request) string {
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		return id
+	}
+
+	return r.URL.Query().Get("Last-Event-ID")
+}
+
+// writeEvent writes e to w using the Server-Sent Events wire format.
+func writeEvent(w http.ResponseWriter, e Event) {
+	if e.Retry > 0 {
+		fmt.Fprintf(w, "retry: %d\n", e.Retry)
+	}
+
+	if e.Type != "" {
+		fmt.Fprintf(w, "event: %s\n", e.Type)
+	}
+
+	fmt.Fprintf(w, "id: %s\n", e.ID)
+
+	for _, line := range strings.Split(e.Data, "\n") {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+
+	fmt.Fprint(w, "\n")
+}
+
+// dispatchSubscriptionUpdates dispatches a Subscription update for every topic s is subscribed
+// to, reflecting whether it just connected (active) or is about to disconnect.
+func (h *Hub) dispatchSubscriptionUpdates(s *Subscriber, active bool) {
+	for _, topic := range s.RawTopics {
+		sub := &Subscription{
+			Context:   "https://mercure.rocks/",
+			ID:        subscriptionsTopicPrefix + url.PathEscape(s.ID) + "/" + url.QueryEscape(topic),
+			Type:      "https://mercure.rocks/Subscription",
+			Topic:     topic,
+			Subscribe: []string{},
+			Publish:   []string{},
+			Active:    active,
+		}
+
+		if s.Claims != nil {
+			if s.Claims.Mercure.Subscribe != nil {
+				sub.Subscribe = s.Claims.Mercure.Subscribe
+			}
+
+			if s.Claims.Mercure.Publish != nil {
+				sub.Publish = s.Claims.Mercure.Publish
+			}
+		}
+
+		data, err := json.MarshalIndent(sub, "", "  ")
+		if err != nil {
+			continue
+		}
+
+		h.transport.Dispatch(&Update{
+			Topics:  []string{sub.ID},
+			Private: true,
+			Event:   Event{ID: strconv.FormatInt(int64(len(data)), 10) + "-" + sub.ID, Data: string(data)},
+		})
+	}
+}
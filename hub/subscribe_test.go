@@ -267,11 +267,6 @@ func TestUnsubscribe(t *testing.T) {
 		defer wg.Done()
 		req := httptest.NewRequest("GET", defaultHubURL+"?topic=http://example.com/books/1", nil).WithContext(ctx)
 		hub.SubscribeHandler(httptest.NewRecorder(), req)
-		assert.Equal(t, 1, len(s.subscribers))
-		for s := range s.subscribers {
-			_, ok := <-s.disconnected
-			assert.False(t, ok)
-		}
 	}()
 
 	for {
@@ -285,6 +280,70 @@ func TestUnsubscribe(t *testing.T) {
 
 	cancel()
 	wg.Wait()
+
+	// The subscriber is pruned from the transport asynchronously once it disconnects, so the
+	// map eventually empties instead of growing with every connection the hub has ever seen.
+	deadline := time.Now().Add(time.Second)
+	for {
+		s.RLock()
+		empty := len(s.subscribers) == 0
+		s.RUnlock()
+
+		if empty {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("subscriber was never pruned from the transport after disconnecting")
+		}
+	}
+}
+
+// TestSubscribersDontLeakAcrossReconnects runs several connect/disconnect cycles and asserts the
+// transport's subscriber set is empty afterwards, not just reduced: memory usage must stay
+// proportional to concurrent connections, not to the total number of connections ever made.
+func TestSubscribersDontLeakAcrossReconnects(t *testing.T) {
+	hub := createAnonymousDummy()
+	s, _ := hub.transport.(*LocalTransport)
+
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		req := httptest.NewRequest("GET", defaultHubURL+"?topic=http://example.com/books/1", nil).WithContext(ctx)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			hub.SubscribeHandler(httptest.NewRecorder(), req)
+		}()
+
+		for {
+			s.RLock()
+			notEmpty := len(s.subscribers) != 0
+			s.RUnlock()
+
+			if notEmpty {
+				break
+			}
+		}
+
+		cancel()
+		<-done
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		s.RLock()
+		empty := len(s.subscribers) == 0
+		s.RUnlock()
+
+		if empty {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("transport still holds %d subscribers after 5 connect/disconnect cycles", len(s.subscribers))
+		}
+	}
 }
 
 func TestSubscribePrivate(t *testing.T) {
@@ -0,0 +1,187 @@
+package hub
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// defaultSubscriberCapacity is used when "subscriber_capacity" isn't set, so a forgotten config
+// key never turns into an unbounded channel.
+const defaultSubscriberCapacity = 64
+
+// errSubscriberOutOfCapacity is the error recorded on a subscriber that got evicted because it
+// didn't drain its outbound buffer quickly enough, analogous to Tendermint's ErrOutOfCapacity.
+var errSubscriberOutOfCapacity = errors.New("hub: subscriber out of capacity, too many events are queued")
+
+var lastSubscriberSeq uint64
+
+// Subscriber represents a client currently connected to the hub and waiting for updates.
+type Subscriber struct {
+	ID                 string
+	EscapedID          string
+	Claims             *claims
+	Topics             []string
+	RawTopics          []string
+	RequestLastEventID string
+	Debug              bool
+	Filter             *Filter
+
+	out          chan *Update
+	disconnected chan struct{}
+	closeOnce    sync.Once
+
+	evictAfter time.Duration
+
+	mu          sync.Mutex
+	lastEventID string
+	err         error
+}
+
+// NewSubscriber creates a new subscriber with a bounded outbound buffer of the given capacity.
+// capacity <= 0 falls back to defaultSubscriberCapacity, so a misconfigured hub never ends up
+// with an unbounded (or zero-length, permanently blocking) channel.
+func NewSubscriber(lastEventID string, capacity int, evictAfter time.Duration, debug bool) *Subscriber {
+	if capacity <= 0 {
+		capacity = defaultSubscriberCapacity
+	}
+
+	id, err := uuid.NewV4()
+
+	var idStr string
+	if err != nil {
+		// extremely unlikely: fall back to a counter-based id rather than failing the subscription
+		idStr = "urn:uuid:seq-" + strconv.FormatUint(atomic.AddUint64(&lastSubscriberSeq, 1), 10)
+	} else {
+		idStr = "urn:uuid:" + id.String()
+	}
+
+	return &Subscriber{
+		ID:                 idStr,
+		RequestLastEventID: lastEventID,
+		Debug:              debug,
+		out:                make(chan *Update, capacity),
+		disconnected:       make(chan struct{}),
+		evictAfter:         evictAfter,
+		lastEventID:        lastEventID,
+	}
+}
+
+// Dispatch delivers the update to the subscriber's outbound buffer if it matches its topic
+// selectors, authorization and filter, without ever blocking: if the buffer is full, the update
+// is dropped and false is returned so the caller can account for it (e.g. towards eviction).
+func (s *Subscriber) Dispatch(u *Update) bool {
+	if !canReceiveUpdate(s, u) || !topicsMatch(s.Topics, u.Topics) || !s.Filter.Match(u) {
+		return true
+	}
+
+	select {
+	case s.out <- u:
+		return true
+	default:
+		return false
+	}
+}
+
+// Receive returns the channel updates are delivered on.
+func (s *Subscriber) Receive() <-chan *Update {
+	return s.out
+}
+
+// Disconnected returns a channel that is closed when the subscriber is disconnected, either
+// because the client went away or because it was evicted.
+func (s *Subscriber) Disconnected() <-chan struct{} {
+	return s.disconnected
+}
+
+// Disconnect marks the subscriber as disconnected. It is safe to call more than once.
+func (s *Subscriber) Disconnect() {
+	s.closeOnce.Do(func() { close(s.disconnected) })
+}
+
+// Evict disconnects the subscriber and records err as the reason, unless it is already
+// disconnected (in which case the first recorded reason, if any, is kept).
+func (s *Subscriber) Evict(err error) {
+	s.mu.Lock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.mu.Unlock()
+
+	s.Disconnect()
+}
+
+// Err returns the error that caused the subscriber to be evicted, or nil for a clean disconnect.
+func (s *Subscriber) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.err
+}
+
+// SetLastEventID records the ID of the last event successfully flushed to the client, so that a
+// reconnecting client can resume from there using Last-Event-ID.
+func (s *Subscriber) SetLastEventID(id string) {
+	s.mu.Lock()
+	s.lastEventID = id
+	s.mu.Unlock()
+}
+
+// LastEventID returns the ID of the last event successfully flushed to the client.
+func (s *Subscriber) LastEventID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lastEventID
+}
+
+// watchCapacity monitors how long the subscriber's outbound buffer has stayed full, and evicts
+// it once that exceeds evictAfter. It never blocks Dispatch: it only observes channel occupancy.
+func (s *Subscriber) watchCapacity(onEvict func(*Subscriber)) {
+	if s.evictAfter <= 0 {
+		return
+	}
+
+	tick := s.evictAfter / 4
+	if tick <= 0 {
+		tick = time.Millisecond
+	}
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	var fullSince time.Time
+
+	for {
+		select {
+		case <-s.disconnected:
+			return
+		case <-ticker.C:
+			if len(s.out) < cap(s.out) {
+				fullSince = time.Time{}
+
+				continue
+			}
+
+			if fullSince.IsZero() {
+				fullSince = time.Now()
+
+				continue
+			}
+
+			if time.Since(fullSince) >= s.evictAfter {
+				s.Evict(errSubscriberOutOfCapacity)
+
+				if onEvict != nil {
+					onEvict(s)
+				}
+
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package hub
+
+// Subscription represents a subscription of a subscriber to a topic, exposed
+// as JSON-LD on the reserved "/.well-known/mercure/subscriptions/{subscriptionID}/{topic}" topic
+// when the "dispatch_subscriptions" config option is enabled.
+type Subscription struct {
+	Context   string   `json:"@context,omitempty"`
+	ID        string   `json:"@id"`
+	Type      string   `json:"@type"`
+	Topic     string   `json:"topic"`
+	Publish   []string `json:"publish"`
+	Active    bool     `json:"active"`
+	Subscribe []string `json:"subscribe"`
+}
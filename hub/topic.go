@@ -0,0 +1,69 @@
+package hub
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// topicSelectorMatches reports whether the given topic selector (either a literal IRI, the "*"
+// wildcard, or a URI template such as "https://example.com/books/{id}") matches topic.
+func topicSelectorMatches(selector, topic string) bool {
+	if selector == "*" || selector == topic {
+		return true
+	}
+
+	re, err := compiledTopicSelector(selector)
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(topic)
+}
+
+// topicsMatch reports whether any of the update's topics is matched by any of the subscriber's
+// topic selectors.
+func topicsMatch(selectors, topics []string) bool {
+	for _, selector := range selectors {
+		for _, topic := range topics {
+			if topicSelectorMatches(selector, topic) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+var (
+	topicSelectorCacheMu sync.RWMutex
+	topicSelectorCache   = make(map[string]*regexp.Regexp)
+	templateVarPattern   = regexp.MustCompile(`\{[^{}]+\}`)
+)
+
+const templatePlaceholderToken = "\x00"
+
+// compiledTopicSelector compiles (and caches) a URI Template-ish topic selector into a regexp,
+// turning every "{var}" placeholder into a greedy wildcard.
+func compiledTopicSelector(selector string) (*regexp.Regexp, error) {
+	topicSelectorCacheMu.RLock()
+	re, ok := topicSelectorCache[selector]
+	topicSelectorCacheMu.RUnlock()
+	if ok {
+		return re, nil
+	}
+
+	withTokens := templateVarPattern.ReplaceAllString(selector, templatePlaceholderToken)
+	pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(withTokens), templatePlaceholderToken, ".+") + "$"
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	topicSelectorCacheMu.Lock()
+	topicSelectorCache[selector] = re
+	topicSelectorCacheMu.Unlock()
+
+	return re, nil
+}
@@ -0,0 +1,17 @@
+package hub
+
+import "errors"
+
+// Transport provides methods to dispatch updates, and to manage subscribers receiving these updates.
+type Transport interface {
+	// Dispatch dispatches an update to all subscribers, and persists it if the transport supports it.
+	Dispatch(update *Update) error
+	// AddSubscriber adds a new subscriber to the list of subscribers, replaying history if the
+	// subscriber has a RequestLastEventID set and the transport persists updates.
+	AddSubscriber(s *Subscriber) error
+	// Close closes the transport, disconnecting every subscriber.
+	Close() error
+}
+
+// errTransportClosed is returned by AddSubscriber and Dispatch when the transport is already closed.
+var errTransportClosed = errors.New("hub: transport is closed")
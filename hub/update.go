@@ -0,0 +1,8 @@
+package hub
+
+// Update represents an update to dispatch to subscribers, linked to a list of topics.
+type Update struct {
+	Topics  []string
+	Private bool
+	Event   Event
+}